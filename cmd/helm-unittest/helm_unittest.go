@@ -10,6 +10,7 @@ import (
 	"github.com/helm-unittest/helm-unittest/pkg/unittest"
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/formatter"
 	"github.com/helm-unittest/helm-unittest/pkg/unittest/printer"
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/vendor"
 	"github.com/spf13/cobra"
 )
 
@@ -21,10 +22,12 @@ type testOptions struct {
 	colored        bool
 	updateSnapshot bool
 	withSubChart   bool
+	skipTests      bool
 	testFiles      []string
 	valuesFiles    []string
 	outputFile     string
 	outputType     string
+	outputDir      string
 	chartTestsPath string
 }
 
@@ -88,6 +91,21 @@ func RunPlugin(cmd *cobra.Command, chartPaths []string) {
 		testConfig.testFiles = []string{defaultFilePattern}
 	}
 
+	var vendorDirs []string
+	for _, chartPath := range chartPaths {
+		dirs, err := vendor.Sync(chartPath)
+		if err != nil {
+			fmt.Println("Error:", fmt.Errorf("failed to resolve test-fixture chart dependencies for %s: %w", chartPath, err))
+			os.Exit(1)
+		}
+		vendorDirs = append(vendorDirs, dirs...)
+	}
+
+	if err := vendor.ValidateDirs(vendorDirs); err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+
 	formatter := formatter.NewFormatter(testConfig.outputFile, testConfig.outputType)
 	printer := printer.NewPrinter(os.Stdout, colored)
 	testRunner = unittest.TestRunner{
@@ -95,11 +113,14 @@ func RunPlugin(cmd *cobra.Command, chartPaths []string) {
 		Formatter:      formatter,
 		UpdateSnapshot: testConfig.updateSnapshot,
 		WithSubChart:   testConfig.withSubChart,
+		SkipTests:      testConfig.skipTests,
+		VendorDirs:     vendorDirs,
 		Strict:         testConfig.useStrict,
 		Failfast:       testConfig.useFailfast,
 		TestFiles:      testConfig.testFiles,
 		ValuesFiles:    testConfig.valuesFiles,
 		OutputFile:     testConfig.outputFile,
+		OutputDir:      testConfig.outputDir,
 		ChartTestsPath: testConfig.chartTestsPath,
 		RenderPath:     renderPath,
 	}
@@ -159,6 +180,11 @@ func InitPluginFlags(cmd *cobra.Command) {
 		"include tests of the subcharts within `charts` folder",
 	)
 
+	cmd.PersistentFlags().BoolVar(
+		&testConfig.skipTests, "skip-tests", false,
+		"skip manifests carrying the helm.sh/hook: test annotation (and files under templates/tests/) before they reach any assertion, can also be set per-suite with skipTests: true",
+	)
+
 	cmd.PersistentFlags().StringVarP(
 		&testConfig.outputFile, "output-file", "o", "",
 		"output-file the file where testresults are written in JUnit format, defaults no output is written to file",
@@ -169,6 +195,11 @@ func InitPluginFlags(cmd *cobra.Command) {
 		"output-type the file-format where testresults are written in, accepted types are (JUnit, NUnit, XUnit, Sonar)",
 	)
 
+	cmd.PersistentFlags().StringVar(
+		&testConfig.outputDir, "output-dir", "",
+		"output-dir the directory where the rendered manifests seen by each test case are written to, one file per template under <output-dir>/<chart>/<suite>/<test-name>/<template-path>, defaults no manifests are written to disk",
+	)
+
 	cmd.PersistentFlags().StringVar(
 		&testConfig.chartTestsPath, "chart-tests-path", "",
 		"chart-tests-path the folder location relative to the chart where a helm chart to render test suites is located",
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/helm-unittest/helm-unittest/pkg/unittest/vendor"
+	"github.com/spf13/cobra"
+)
+
+var vendorCmd = &cobra.Command{
+	Use:   "vendor [flags] CHART [...]",
+	Short: "resolve test-fixture chart dependencies declared in tests/chartfile.yaml",
+	Long: `Reads tests/chartfile.yaml under each given chart and resolves its
+declared dependencies into tests/vendor/<alias-or-name>, recording the exact
+version and digest fetched in tests/chartfile.lock.
+
+This lets test suites depend on upstream subcharts (e.g. bitnami/common) or
+sibling charts without adding them to the chart's own Chart.yaml dependencies
+or checking tarballs into git. "helm unittest" resolves missing vendored
+charts the same way before rendering, so a plain test run also stays
+reproducible as long as the lockfile is committed.
+`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runVendor,
+}
+
+var vendorAddCmd = &cobra.Command{
+	Use:   "add CHART repo/name@version",
+	Short: "append a chart dependency to tests/chartfile.yaml",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runVendorAdd,
+}
+
+func init() {
+	vendorCmd.AddCommand(vendorAddCmd)
+	cmd.AddCommand(vendorCmd)
+}
+
+func runVendor(cmd *cobra.Command, chartPaths []string) error {
+	for _, chartPath := range chartPaths {
+		dirs, err := vendor.Sync(chartPath)
+		if err != nil {
+			return err
+		}
+		for _, dir := range dirs {
+			fmt.Printf("vendored %s\n", dir)
+		}
+	}
+	return nil
+}
+
+func runVendorAdd(cmd *cobra.Command, args []string) error {
+	chartPath, ref := args[0], args[1]
+
+	dep, err := vendor.Add(chartPath, ref)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("added %s@%s from %s to %s\n", dep.Name, dep.Version, dep.Repo, chartPath)
+	return nil
+}
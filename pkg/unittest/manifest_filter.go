@@ -0,0 +1,74 @@
+package unittest
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+)
+
+const testHookAnnotation = "helm.sh/hook"
+
+// testsTemplateDir is the conventional location of Helm test-hook templates
+// within a chart, e.g. "mychart/templates/tests/test-connection.yaml".
+const testsTemplateDir = "templates/tests/"
+
+var testHookValues = map[string]bool{
+	"test":         true,
+	"test-success": true,
+	"test-failure": true,
+}
+
+// EffectiveSkipTests resolves whether test-hook manifests should be dropped
+// for a given suite: the global --skip-tests flag wins if set, otherwise the
+// suite's own skipTests field applies.
+func EffectiveSkipTests(globalSkipTests bool, suite *TestSuite) bool {
+	return globalSkipTests || (suite != nil && suite.SkipTests)
+}
+
+// IsTestsTemplatePath reports whether templatePath is a Helm test-hook
+// template by convention, i.e. lives under a "templates/tests/" directory.
+func IsTestsTemplatePath(templatePath string) bool {
+	return strings.Contains(filepath.ToSlash(templatePath), testsTemplateDir)
+}
+
+// FilterTestHookManifests drops manifests carrying a helm.sh/hook: test (or
+// test-success/test-failure) annotation when skip is true, before they reach
+// any Validatable. Combined with skipping templates/tests/ templates at
+// render time via IsTestsTemplatePath, this keeps snapshot diffs and
+// count-based asserts from shifting whenever a chart gains a test hook.
+func FilterTestHookManifests(manifests []common.K8sManifest, skip bool) []common.K8sManifest {
+	if !skip {
+		return manifests
+	}
+
+	filtered := make([]common.K8sManifest, 0, len(manifests))
+	for _, manifest := range manifests {
+		if !isTestHookManifest(manifest) {
+			filtered = append(filtered, manifest)
+		}
+	}
+
+	return filtered
+}
+
+func isTestHookManifest(manifest common.K8sManifest) bool {
+	metadata, ok := manifest["metadata"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	hooks, _ := annotations[testHookAnnotation].(string)
+	for _, hook := range strings.Split(hooks, ",") {
+		if testHookValues[strings.TrimSpace(hook)] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,72 @@
+package unittest
+
+import (
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+)
+
+func manifestWithHook(hook string) common.K8sManifest {
+	return common.K8sManifest{
+		"kind": "Pod",
+		"metadata": map[string]interface{}{
+			"name": "test-pod",
+			"annotations": map[string]interface{}{
+				testHookAnnotation: hook,
+			},
+		},
+	}
+}
+
+func TestFilterTestHookManifests(t *testing.T) {
+	manifests := []common.K8sManifest{
+		{"kind": "Deployment", "metadata": map[string]interface{}{"name": "app"}},
+		manifestWithHook("test"),
+		manifestWithHook("test-success"),
+		manifestWithHook("pre-install,test-failure"),
+	}
+
+	filtered := FilterTestHookManifests(manifests, true)
+
+	if len(filtered) != 1 {
+		t.Fatalf("expected 1 manifest to survive skip-tests filtering, got %d", len(filtered))
+	}
+	if filtered[0]["kind"] != "Deployment" {
+		t.Fatalf("expected the Deployment manifest to survive, got %v", filtered[0])
+	}
+}
+
+func TestFilterTestHookManifestsNoOpWhenNotSkipping(t *testing.T) {
+	manifests := []common.K8sManifest{manifestWithHook("test")}
+
+	filtered := FilterTestHookManifests(manifests, false)
+
+	if len(filtered) != 1 {
+		t.Fatalf("expected manifests to pass through unchanged when skip is false, got %d", len(filtered))
+	}
+}
+
+func TestIsTestsTemplatePath(t *testing.T) {
+	cases := map[string]bool{
+		"mychart/templates/tests/test-connection.yaml": true,
+		"mychart/templates/deployment.yaml":            false,
+	}
+
+	for path, want := range cases {
+		if got := IsTestsTemplatePath(path); got != want {
+			t.Errorf("IsTestsTemplatePath(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestEffectiveSkipTests(t *testing.T) {
+	if !EffectiveSkipTests(true, nil) {
+		t.Error("expected global flag alone to enable skipping")
+	}
+	if EffectiveSkipTests(false, nil) {
+		t.Error("expected no skipping when neither global flag nor suite is set")
+	}
+	if !EffectiveSkipTests(false, &TestSuite{SkipTests: true}) {
+		t.Error("expected suite-level skipTests: true to enable skipping")
+	}
+}
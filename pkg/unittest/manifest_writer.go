@@ -0,0 +1,74 @@
+package unittest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// documentSeparator is the YAML document separator Helm itself emits
+// between the manifests rendered from a single template.
+const documentSeparator = "\n---"
+
+// WriteManifestsToOutputDir writes the manifests rendered for one test case
+// to disk under <outputDir>/<chart>/<suite>/<testName>/<templatePath>, so CI
+// pipelines can diff or upload the exact material the asserts saw. This is
+// the --output-dir analogue of `helm template --output-dir`.
+//
+// manifestsByTemplate maps each rendered template's path to its raw output;
+// a template producing several manifests joined by "---" is split so each
+// document lands in its own file.
+func WriteManifestsToOutputDir(outputDir, chart, suite, testName string, manifestsByTemplate map[string]string) error {
+	for templatePath, raw := range manifestsByTemplate {
+		documents := splitYAMLDocuments(raw)
+
+		for i, document := range documents {
+			targetPath := filepath.Join(outputDir, chart, suite, testName, templatePath)
+			if len(documents) > 1 {
+				targetPath = indexedPath(targetPath, i)
+			}
+
+			if err := os.MkdirAll(filepath.Dir(targetPath), 0755); err != nil {
+				return fmt.Errorf("failed to create %s: %w", filepath.Dir(targetPath), err)
+			}
+
+			if err := os.WriteFile(targetPath, []byte(document), 0644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", targetPath, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// splitYAMLDocuments splits raw on the "---" document separator, dropping
+// any document that is empty once trimmed (e.g. a leading separator).
+func splitYAMLDocuments(raw string) []string {
+	parts := strings.Split("\n"+raw, documentSeparator)
+
+	documents := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		document := strings.TrimPrefix(part, "\n")
+		if !strings.HasSuffix(document, "\n") {
+			document += "\n"
+		}
+		documents = append(documents, document)
+	}
+
+	if len(documents) == 0 {
+		return []string{raw}
+	}
+
+	return documents
+}
+
+// indexedPath inserts "-<index>" before path's extension, so multiple
+// documents rendered from the same template don't collide on disk.
+func indexedPath(path string, index int) string {
+	ext := filepath.Ext(path)
+	return fmt.Sprintf("%s-%d%s", strings.TrimSuffix(path, ext), index, ext)
+}
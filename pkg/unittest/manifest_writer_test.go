@@ -0,0 +1,58 @@
+package unittest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteManifestsToOutputDirSingleDocument(t *testing.T) {
+	outputDir := t.TempDir()
+
+	manifests := map[string]string{
+		"templates/deployment.yaml": "kind: Deployment\n",
+	}
+
+	if err := WriteManifestsToOutputDir(outputDir, "mychart", "test my deployment", "should be a Deployment", manifests); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	path := filepath.Join(outputDir, "mychart", "test my deployment", "should be a Deployment", "templates/deployment.yaml")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected manifest to be written to %s: %v", path, err)
+	}
+	if string(content) != "kind: Deployment\n" {
+		t.Fatalf("unexpected content: %q", content)
+	}
+}
+
+func TestWriteManifestsToOutputDirSplitsMultipleDocuments(t *testing.T) {
+	outputDir := t.TempDir()
+
+	manifests := map[string]string{
+		"templates/all.yaml": "kind: Deployment\n---\nkind: Service\n",
+	}
+
+	if err := WriteManifestsToOutputDir(outputDir, "mychart", "suite", "test", manifests); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	base := filepath.Join(outputDir, "mychart", "suite", "test")
+
+	first, err := os.ReadFile(filepath.Join(base, "templates/all-0.yaml"))
+	if err != nil {
+		t.Fatalf("expected first document to be written: %v", err)
+	}
+	if string(first) != "kind: Deployment\n" {
+		t.Fatalf("unexpected first document content: %q", first)
+	}
+
+	second, err := os.ReadFile(filepath.Join(base, "templates/all-1.yaml"))
+	if err != nil {
+		t.Fatalf("expected second document to be written: %v", err)
+	}
+	if string(second) != "kind: Service\n" {
+		t.Fatalf("unexpected second document content: %q", second)
+	}
+}
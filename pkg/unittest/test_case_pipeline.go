@@ -0,0 +1,62 @@
+package unittest
+
+import (
+	"fmt"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+)
+
+// PrepareTestCaseManifests is the single hook a test runner calls once per
+// rendered test case, after Helm has produced each template's raw output
+// and before any manifest reaches a Validatable. It:
+//
+//  1. resolves whether test-hook manifests should be dropped for this suite
+//     via EffectiveSkipTests (global --skip-tests wins, otherwise the
+//     suite's own skipTests: true applies);
+//  2. drops them, via FilterTestHookManifests and IsTestsTemplatePath;
+//  3. writes the raw output of every template that still has a manifest
+//     after filtering to outputDir when set, via WriteManifestsToOutputDir,
+//     mirroring `helm template --output-dir`. A template is written whole:
+//     test-hook documents in a template outside templates/tests/ that also
+//     keeps other manifests are written too.
+//
+// suite is the parsed test suite this test case belongs to; pass nil if no
+// suite-level skipTests override applies. rawByTemplate maps each rendered
+// template's path to its raw YAML output; manifestsByTemplate is the same
+// content already parsed into common.K8sManifest, keyed by the same template
+// paths, for the filtering step. The order of the returned manifests across
+// templates is not defined.
+func PrepareTestCaseManifests(
+	globalSkipTests bool,
+	suite *TestSuite,
+	outputDir, chart, suiteName, testName string,
+	rawByTemplate map[string]string,
+	manifestsByTemplate map[string][]common.K8sManifest,
+) ([]common.K8sManifest, error) {
+	skipTests := EffectiveSkipTests(globalSkipTests, suite)
+
+	var all []common.K8sManifest
+	renderedByTemplate := make(map[string]string, len(rawByTemplate))
+
+	for templatePath, raw := range rawByTemplate {
+		if skipTests && IsTestsTemplatePath(templatePath) {
+			continue
+		}
+
+		manifests := FilterTestHookManifests(manifestsByTemplate[templatePath], skipTests)
+		if len(manifests) == 0 {
+			continue
+		}
+
+		all = append(all, manifests...)
+		renderedByTemplate[templatePath] = raw
+	}
+
+	if outputDir != "" {
+		if err := WriteManifestsToOutputDir(outputDir, chart, suiteName, testName, renderedByTemplate); err != nil {
+			return nil, fmt.Errorf("failed to write rendered manifests for %s/%s/%s: %w", chart, suiteName, testName, err)
+		}
+	}
+
+	return all, nil
+}
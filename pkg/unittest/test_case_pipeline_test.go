@@ -0,0 +1,86 @@
+package unittest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/helm-unittest/helm-unittest/internal/common"
+)
+
+func TestPrepareTestCaseManifestsDropsHooksAndWritesOutput(t *testing.T) {
+	outputDir := t.TempDir()
+
+	rawByTemplate := map[string]string{
+		"templates/deployment.yaml":        "kind: Deployment\nmetadata:\n  name: app\n",
+		"templates/tests/test-connection.yaml": "kind: Pod\nmetadata:\n  name: test-connection\n  annotations:\n    helm.sh/hook: test\n",
+	}
+
+	manifestsByTemplate := map[string][]common.K8sManifest{
+		"templates/deployment.yaml": {
+			{"kind": "Deployment", "metadata": map[string]interface{}{"name": "app"}},
+		},
+		"templates/tests/test-connection.yaml": {
+			manifestWithHook("test"),
+		},
+	}
+
+	manifests, err := PrepareTestCaseManifests(true, nil, outputDir, "mychart", "my suite", "should deploy",
+		rawByTemplate, manifestsByTemplate)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(manifests) != 1 || manifests[0]["kind"] != "Deployment" {
+		t.Fatalf("expected only the Deployment manifest to survive, got %v", manifests)
+	}
+
+	base := filepath.Join(outputDir, "mychart", "my suite", "should deploy")
+
+	if _, err := os.Stat(filepath.Join(base, "templates/deployment.yaml")); err != nil {
+		t.Errorf("expected deployment.yaml to be written to the output dir: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(base, "templates/tests/test-connection.yaml")); !os.IsNotExist(err) {
+		t.Error("expected the skipped test-hook template to not be written to the output dir")
+	}
+}
+
+func TestPrepareTestCaseManifestsNoOutputDir(t *testing.T) {
+	rawByTemplate := map[string]string{
+		"templates/deployment.yaml": "kind: Deployment\n",
+	}
+	manifestsByTemplate := map[string][]common.K8sManifest{
+		"templates/deployment.yaml": {
+			{"kind": "Deployment"},
+		},
+	}
+
+	manifests, err := PrepareTestCaseManifests(false, nil, "", "mychart", "suite", "test", rawByTemplate, manifestsByTemplate)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(manifests) != 1 {
+		t.Fatalf("expected manifests to pass through when skipTests is false, got %d", len(manifests))
+	}
+}
+
+func TestPrepareTestCaseManifestsHonorsSuiteLevelSkipTests(t *testing.T) {
+	rawByTemplate := map[string]string{
+		"templates/tests/test-connection.yaml": "kind: Pod\nmetadata:\n  name: test-connection\n  annotations:\n    helm.sh/hook: test\n",
+	}
+	manifestsByTemplate := map[string][]common.K8sManifest{
+		"templates/tests/test-connection.yaml": {
+			manifestWithHook("test"),
+		},
+	}
+
+	suite := &TestSuite{Name: "suite with per-suite skip", SkipTests: true}
+
+	manifests, err := PrepareTestCaseManifests(false, suite, "", "mychart", "suite", "test", rawByTemplate, manifestsByTemplate)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(manifests) != 0 {
+		t.Fatalf("expected the suite's own skipTests: true to drop the test-hook manifest even though the global flag is false, got %v", manifests)
+	}
+}
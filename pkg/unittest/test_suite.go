@@ -0,0 +1,34 @@
+package unittest
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TestJob is a single `it:` case within a test suite.
+type TestJob struct {
+	Name    string                   `yaml:"it"`
+	Asserts []map[string]interface{} `yaml:"asserts"`
+}
+
+// TestSuite is the parsed form of a test suite YAML file.
+type TestSuite struct {
+	Name      string    `yaml:"suite"`
+	Templates []string  `yaml:"templates"`
+	Tests     []TestJob `yaml:"tests"`
+	// SkipTests mirrors the --skip-tests flag for this suite only: when true,
+	// manifests carrying a helm.sh/hook: test annotation (or rendered from a
+	// templates/tests/ template) are dropped before any assert runs, even if
+	// the global flag isn't set.
+	SkipTests bool `yaml:"skipTests,omitempty"`
+}
+
+// LoadTestSuite parses a test suite file's raw YAML content.
+func LoadTestSuite(content []byte) (*TestSuite, error) {
+	suite := &TestSuite{}
+	if err := yaml.Unmarshal(content, suite); err != nil {
+		return nil, fmt.Errorf("failed to parse test suite: %w", err)
+	}
+	return suite, nil
+}
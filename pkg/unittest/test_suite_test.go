@@ -0,0 +1,63 @@
+package unittest
+
+import "testing"
+
+func TestLoadTestSuiteParsesSkipTests(t *testing.T) {
+	content := []byte(`
+suite: test my deployment
+templates:
+  - deployment.yaml
+skipTests: true
+`)
+
+	suite, err := LoadTestSuite(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !suite.SkipTests {
+		t.Error("expected skipTests: true to be parsed")
+	}
+	if suite.Name != "test my deployment" {
+		t.Errorf("unexpected suite name: %q", suite.Name)
+	}
+}
+
+func TestLoadTestSuiteDefaultsSkipTestsFalse(t *testing.T) {
+	suite, err := LoadTestSuite([]byte("suite: test my deployment\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if suite.SkipTests {
+		t.Error("expected skipTests to default to false")
+	}
+}
+
+func TestLoadTestSuiteParsesTests(t *testing.T) {
+	content := []byte(`
+suite: test my deployment
+templates:
+  - deployment.yaml
+tests:
+  - it: should be a Deployment
+    asserts:
+      - isKind:
+          of: Deployment
+`)
+
+	suite, err := LoadTestSuite(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(suite.Tests) != 1 {
+		t.Fatalf("expected 1 test job, got %d", len(suite.Tests))
+	}
+	if suite.Tests[0].Name != "should be a Deployment" {
+		t.Errorf("unexpected test name: %q", suite.Tests[0].Name)
+	}
+	if len(suite.Tests[0].Asserts) != 1 {
+		t.Fatalf("expected 1 assert, got %d", len(suite.Tests[0].Asserts))
+	}
+}
@@ -2,28 +2,108 @@ package validators
 
 import (
 	"cmp"
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/helm-unittest/helm-unittest/internal/common"
 )
 
-// FailedTemplateValidator validate whether the errorMessage equal to errorMessage
+// FailedTemplateValidator validate whether the error raised while rendering the chart
+// matches the configured message(s) and/or pattern(s).
+//
+// A single `errorMessage`/`errorPattern` still works as before. The plural
+// `errorMessages`/`errorPatterns` fields accept several candidates at once, and
+// `errorContains` lists plain substrings to look for; by
+// default any one of the configured checks satisfying the error is enough, set `all:
+// true` to require every one of them to match. All checks are evaluated against every
+// message in the rendering error chain (as produced by errors.Unwrap), not only the
+// top-level Error() string, so a test can pin an inner "cannot load requirements:" or
+// "execution error at (...)" cause without restating the whole wrapped message.
+//
+// `errorFile`, `errorLine` and `errorTemplate` match against the structured
+// "template: <path>:<line>[:<col>]: executing \"<tmpl>\" at <expr>:" prefix
+// text/template and Helm's engine emit, letting a test pin where a failure
+// originated without coupling to wording that drifts across Helm versions.
 type FailedTemplateValidator struct {
-	ErrorMessage string
-	ErrorPattern string
+	ErrorMessage  string
+	ErrorPattern  string
+	ErrorMessages []string
+	ErrorPatterns []string
+	ErrorContains []string
+	ErrorFile     string
+	ErrorLine     string
+	ErrorTemplate string
+	All           bool
+}
+
+// isEmpty reports whether no error assertion at all was configured.
+func (a FailedTemplateValidator) isEmpty() bool {
+	return a.ErrorMessage == "" && a.ErrorPattern == "" && a.ErrorFile == "" &&
+		a.ErrorLine == "" && a.ErrorTemplate == "" &&
+		len(a.ErrorMessages) == 0 && len(a.ErrorPatterns) == 0 && len(a.ErrorContains) == 0
+}
+
+// diagnostics parses every message in chain that carries the "template: ..."
+// prefix into a renderDiagnostic, skipping messages that don't match it.
+func diagnostics(chain []string) []renderDiagnostic {
+	var diags []renderDiagnostic
+	for _, msg := range chain {
+		if diag, ok := parseRenderDiagnostic(msg); ok {
+			diags = append(diags, diag)
+		}
+	}
+	return diags
+}
+
+// messages returns the configured exact-match candidates, singular and plural combined.
+func (a FailedTemplateValidator) messages() []string {
+	if a.ErrorMessage == "" {
+		return a.ErrorMessages
+	}
+	return append([]string{a.ErrorMessage}, a.ErrorMessages...)
+}
+
+// patterns returns the configured regex candidates, singular and plural combined.
+func (a FailedTemplateValidator) patterns() []string {
+	if a.ErrorPattern == "" {
+		return a.ErrorPatterns
+	}
+	return append([]string{a.ErrorPattern}, a.ErrorPatterns...)
+}
+
+// errorChain walks err via errors.Unwrap, collecting every message in the chain so
+// assertions can target an inner cause instead of the fully wrapped string.
+func errorChain(err error) []string {
+	chain := make([]string, 0, 1)
+	for err != nil {
+		chain = append(chain, err.Error())
+		err = errors.Unwrap(err)
+	}
+	return chain
 }
 
 func (a FailedTemplateValidator) failInfo(actual interface{}, manifestIndex, actualIndex int, not bool) []string {
 	customMessage := " to equal"
-	if a.ErrorPattern != "" {
+	if len(a.patterns()) > 0 {
 		customMessage = " to match"
 	}
 
 	message := cmp.Or(a.ErrorMessage, a.ErrorPattern)
+	if message == "" && len(a.ErrorMessages) > 0 {
+		message = strings.Join(a.ErrorMessages, ", ")
+	}
+	if message == "" && len(a.ErrorPatterns) > 0 {
+		message = strings.Join(a.ErrorPatterns, ", ")
+	}
+	if message == "" && len(a.ErrorContains) > 0 {
+		customMessage = " to contain"
+		message = strings.Join(a.ErrorContains, ", ")
+	}
 
 	log.WithField("validator", "failed_template").Debugln("expected content:", message)
 	log.WithField("validator", "failed_template").Debugln("actual content:", actual)
@@ -37,37 +117,37 @@ func (a FailedTemplateValidator) failInfo(actual interface{}, manifestIndex, act
 		)
 	}
 
+	actualChain := []string{fmt.Sprintf("%s", actual)}
+	if err, ok := actual.(error); ok {
+		actualChain = errorChain(err)
+	}
+
 	return splitInfof(
 		setFailFormat(not, false, true, false, customMessage),
 		manifestIndex,
 		actualIndex,
 		message,
-		fmt.Sprintf("%s", actual),
+		strings.Join(actualChain, "\n"),
 	)
 }
 
 func (a FailedTemplateValidator) validateManifests(manifests []common.K8sManifest, context *ValidateContext) (bool, []string) {
 	validateSuccess := true
 	validateErrors := make([]string, 0)
-	fmt.Println("I'm in required validateManifests")
 
 	for idx, manifest := range manifests {
-		fmt.Println("manifest:", manifest)
 		currentSuccess := false
 		validateSingleErrors := []string{}
 		actual := manifest[common.RAW]
 
-		if a == (FailedTemplateValidator{}) && !context.Negative {
+		if a.isEmpty() && !context.Negative {
 			// If the validator is empty and the context is not negative,
 			// continue to the next iteration without throwing an error.
 			continue
 		}
 
-		if a.ErrorPattern != "" {
-			fmt.Println("errorPattern: [", a.ErrorPattern, "]")
-			currentSuccess, validateSingleErrors = a.validateErrorPattern(actual, idx, -1, context)
-		} else if a.ErrorMessage != "" {
-			currentSuccess, validateSingleErrors = a.validateErrorMessage(actual, idx, -1, context)
+		if !a.isEmpty() {
+			currentSuccess, validateSingleErrors = a.validateErrorChain(actual, []string{fmt.Sprintf("%s", actual)}, idx, -1, context)
 		} else {
 			currentSuccess = true
 		}
@@ -89,14 +169,65 @@ func (a FailedTemplateValidator) validateManifests(manifests []common.K8sManifes
 	return validateSuccess, validateErrors
 }
 
-func (a FailedTemplateValidator) validateErrorPattern(actual interface{}, manifestIndex, actualIndex int, context *ValidateContext) (bool, []string) {
-	p, err := regexp.Compile(a.ErrorPattern)
-	if err != nil {
-		errorMessage := splitInfof(errorFormat, -1, -1, err.Error())
-		return false, errorMessage
+// validateErrorChain matches the configured message/pattern/contains checks against
+// every entry of chain, combining the individual results with the "any"/"all" mode.
+func (a FailedTemplateValidator) validateErrorChain(actual interface{}, chain []string, manifestIndex, actualIndex int, context *ValidateContext) (bool, []string) {
+	var results []bool
+
+	for _, pattern := range a.patterns() {
+		p, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, splitInfof(errorFormat, -1, -1, err.Error())
+		}
+
+		matched := false
+		for _, msg := range chain {
+			if p.MatchString(msg) {
+				matched = true
+				break
+			}
+		}
+		results = append(results, matched)
+	}
+
+	for _, expected := range a.messages() {
+		matched := false
+		for _, msg := range chain {
+			if reflect.DeepEqual(expected, msg) {
+				matched = true
+				break
+			}
+		}
+		results = append(results, matched)
+	}
+
+	for _, substr := range a.ErrorContains {
+		matched := false
+		for _, msg := range chain {
+			if strings.Contains(msg, substr) {
+				matched = true
+				break
+			}
+		}
+		results = append(results, matched)
+	}
+
+	if a.ErrorFile != "" || a.ErrorLine != "" || a.ErrorTemplate != "" {
+		matched := false
+		for _, diag := range diagnostics(chain) {
+			if (a.ErrorFile == "" || diag.File == a.ErrorFile) &&
+				(a.ErrorLine == "" || diag.Line == a.ErrorLine) &&
+				(a.ErrorTemplate == "" || diag.Template == a.ErrorTemplate) {
+				matched = true
+				break
+			}
+		}
+		results = append(results, matched)
 	}
 
-	if (actual != nil && p.MatchString(actual.(string))) == context.Negative {
+	satisfied := combine(a.All, results)
+
+	if satisfied == context.Negative {
 		errorMessage := a.failInfo(actual, manifestIndex, actualIndex, context.Negative)
 		return false, errorMessage
 	}
@@ -104,13 +235,29 @@ func (a FailedTemplateValidator) validateErrorPattern(actual interface{}, manife
 	return true, []string{}
 }
 
-func (a FailedTemplateValidator) validateErrorMessage(actual interface{}, manifestIndex, actualIndex int, context *ValidateContext) (bool, []string) {
-	if (actual != nil && reflect.DeepEqual(a.ErrorMessage, actual.(string))) == context.Negative {
-		errorMessage := a.failInfo(actual, manifestIndex, actualIndex, context.Negative)
-		return false, errorMessage
+// combine reduces a set of per-check results into a single verdict according to mode:
+// all=true requires every check to have matched, all=false (the default) requires
+// at least one. An empty result set is considered satisfied.
+func combine(all bool, results []bool) bool {
+	if len(results) == 0 {
+		return true
 	}
 
-	return true, []string{}
+	if all {
+		for _, r := range results {
+			if !r {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, r := range results {
+		if r {
+			return true
+		}
+	}
+	return false
 }
 
 // Validate implement Validatable
@@ -128,12 +275,11 @@ func (a FailedTemplateValidator) Validate(context *ValidateContext) (bool, []str
 
 	if context.RenderError != nil {
 		// Validating error, when the errorSource is due to rendering errors
-		if a.ErrorPattern != "" {
-			return a.validateErrorPattern(context.RenderError.Error(), -1, -1, context)
-		} else if a.ErrorMessage != "" {
-			return a.validateErrorMessage(context.RenderError.Error(), -1, -1, context)
-		} else {
+		if a.isEmpty() {
 			validateSuccess = true
+		} else {
+			chain := errorChain(context.RenderError)
+			validateSuccess, validateErrors = a.validateErrorChain(context.RenderError, chain, -1, -1, context)
 		}
 	} else {
 		var errorsToAppend []string
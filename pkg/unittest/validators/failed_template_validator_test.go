@@ -0,0 +1,73 @@
+package validators
+
+import (
+	"fmt"
+	"testing"
+)
+
+type wrappedError struct {
+	msg   string
+	cause error
+}
+
+func (e wrappedError) Error() string { return e.msg }
+func (e wrappedError) Unwrap() error { return e.cause }
+
+func TestErrorChainWalksWrappedErrors(t *testing.T) {
+	err := wrappedError{
+		msg: "cannot load requirements",
+		cause: wrappedError{
+			msg: "execution error at (chart/templates/x.yaml:12): nil pointer evaluating interface {}.bar",
+		},
+	}
+
+	chain := errorChain(err)
+
+	want := []string{
+		"cannot load requirements",
+		"execution error at (chart/templates/x.yaml:12): nil pointer evaluating interface {}.bar",
+	}
+
+	if len(chain) != len(want) {
+		t.Fatalf("expected chain of length %d, got %d: %v", len(want), len(chain), chain)
+	}
+	for i, msg := range want {
+		if chain[i] != msg {
+			t.Errorf("chain[%d] = %q, want %q", i, chain[i], msg)
+		}
+	}
+}
+
+func TestErrorChainSingleError(t *testing.T) {
+	chain := errorChain(fmt.Errorf("boom"))
+	if len(chain) != 1 || chain[0] != "boom" {
+		t.Fatalf("expected single-element chain [\"boom\"], got %v", chain)
+	}
+}
+
+func TestCombineAnyMode(t *testing.T) {
+	if !combine(false, []bool{false, false, true}) {
+		t.Error("expected any-mode to be satisfied when at least one result is true")
+	}
+	if combine(false, []bool{false, false}) {
+		t.Error("expected any-mode to fail when no result is true")
+	}
+}
+
+func TestCombineAllMode(t *testing.T) {
+	if !combine(true, []bool{true, true}) {
+		t.Error("expected all-mode to be satisfied when every result is true")
+	}
+	if combine(true, []bool{true, false}) {
+		t.Error("expected all-mode to fail when any result is false")
+	}
+}
+
+func TestCombineEmptyResultsIsSatisfied(t *testing.T) {
+	if !combine(false, nil) {
+		t.Error("expected no configured checks to be vacuously satisfied in any-mode")
+	}
+	if !combine(true, nil) {
+		t.Error("expected no configured checks to be vacuously satisfied in all-mode")
+	}
+}
@@ -0,0 +1,54 @@
+package validators
+
+import "regexp"
+
+// renderDiagnosticPattern recognizes the "template: <path>:<line>[:<col>]:
+// [executing \"<tmpl>\" at <expr>]:" prefix text/template and Helm's engine
+// produce, e.g.:
+//
+//	template: mychart/templates/deployment.yaml:42:15: executing "mychart/templates/deployment.yaml" at <.Values.foo>: nil pointer evaluating interface {}.bar
+//
+// file is matched lazily rather than up to the first colon: a Windows path
+// (e.g. "C:\charts\mychart\templates\deployment.yaml") contains a colon of
+// its own, so anchoring on the first colon would cut the drive letter off
+// as the whole "file". The lazy match instead ends file at the first
+// ":<line>[:<col>]: ", so a column is never mistaken for the line.
+var renderDiagnosticPattern = regexp.MustCompile(
+	`^template: (?P<file>.+?):(?P<line>\d+)(?::\d+)?: (?:executing "(?P<template>[^"]+)" at (?P<expression><[^>]*>): )?`,
+)
+
+// renderDiagnostic is the structured form of a text/template render error,
+// letting an assertion pin the file, line or template an error originated
+// from instead of matching the full, Helm-version-dependent message text.
+type renderDiagnostic struct {
+	File       string
+	Line       string
+	Template   string
+	Expression string
+}
+
+// parseRenderDiagnostic extracts a renderDiagnostic from message, returning
+// ok=false when message doesn't start with the "template: ..." prefix Helm's
+// engine emits.
+func parseRenderDiagnostic(message string) (renderDiagnostic, bool) {
+	match := renderDiagnosticPattern.FindStringSubmatch(message)
+	if match == nil {
+		return renderDiagnostic{}, false
+	}
+
+	diag := renderDiagnostic{}
+	for i, name := range renderDiagnosticPattern.SubexpNames() {
+		switch name {
+		case "file":
+			diag.File = match[i]
+		case "line":
+			diag.Line = match[i]
+		case "template":
+			diag.Template = match[i]
+		case "expression":
+			diag.Expression = match[i]
+		}
+	}
+
+	return diag, true
+}
@@ -0,0 +1,84 @@
+package validators
+
+import "testing"
+
+func TestParseRenderDiagnostic(t *testing.T) {
+	cases := []struct {
+		name    string
+		message string
+		want    renderDiagnostic
+	}{
+		{
+			name:    "executing with column and expression",
+			message: `template: mychart/templates/deployment.yaml:42:15: executing "mychart/templates/deployment.yaml" at <.Values.foo>: nil pointer evaluating interface {}.bar`,
+			want: renderDiagnostic{
+				File:       "mychart/templates/deployment.yaml",
+				Line:       "42",
+				Template:   "mychart/templates/deployment.yaml",
+				Expression: "<.Values.foo>",
+			},
+		},
+		{
+			name:    "no column, still has executing clause",
+			message: `template: mychart/templates/_helpers.tpl:7: executing "mychart/templates/_helpers.tpl" at <.Values.bar>: map has no entry for key "bar"`,
+			want: renderDiagnostic{
+				File:       "mychart/templates/_helpers.tpl",
+				Line:       "7",
+				Template:   "mychart/templates/_helpers.tpl",
+				Expression: "<.Values.bar>",
+			},
+		},
+		{
+			name:    "no executing clause at all",
+			message: `template: mychart/templates/deployment.yaml:3: unexpected EOF`,
+			want: renderDiagnostic{
+				File: "mychart/templates/deployment.yaml",
+				Line: "3",
+			},
+		},
+		{
+			name:    "multi-line message keeps only the first line's diagnostic",
+			message: "template: mychart/templates/deployment.yaml:1: parse error\nadditional context on the next line",
+			want: renderDiagnostic{
+				File: "mychart/templates/deployment.yaml",
+				Line: "1",
+			},
+		},
+		{
+			name:    "windows path with its own drive-letter colon",
+			message: `template: C:\charts\mychart\templates\deployment.yaml:42:15: executing "C:\charts\mychart\templates\deployment.yaml" at <.Values.foo>: nil pointer evaluating interface {}.bar`,
+			want: renderDiagnostic{
+				File:       `C:\charts\mychart\templates\deployment.yaml`,
+				Line:       "42",
+				Template:   `C:\charts\mychart\templates\deployment.yaml`,
+				Expression: "<.Values.foo>",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := parseRenderDiagnostic(c.message)
+			if !ok {
+				t.Fatalf("expected message to be recognized as a render diagnostic: %q", c.message)
+			}
+			if got != c.want {
+				t.Errorf("parseRenderDiagnostic(%q) = %+v, want %+v", c.message, got, c.want)
+			}
+		})
+	}
+}
+
+func TestParseRenderDiagnosticNoMatch(t *testing.T) {
+	cases := []string{
+		"",
+		"cannot load requirements: some other error",
+		"execution error at (mychart/templates/deployment.yaml:12): boom",
+	}
+
+	for _, message := range cases {
+		if _, ok := parseRenderDiagnostic(message); ok {
+			t.Errorf("expected %q to not match the template: prefix", message)
+		}
+	}
+}
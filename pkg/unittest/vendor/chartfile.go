@@ -0,0 +1,145 @@
+// Package vendor resolves and materializes test-only chart dependencies
+// declared in tests/chartfile.yaml, without touching the chart's own
+// Chart.yaml dependencies block.
+package vendor
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ChartfilePath is the default location of the test-fixture chart manifest,
+// relative to the chart under test.
+const ChartfilePath = "tests/chartfile.yaml"
+
+// LockfilePath is the default location of the resolved lockfile written
+// alongside the chartfile.
+const LockfilePath = "tests/chartfile.lock"
+
+// VendorDir is the default directory charts are extracted into.
+const VendorDir = "tests/vendor"
+
+// Dependency describes one external chart used only by test suites.
+type Dependency struct {
+	Name    string `yaml:"name"`
+	Repo    string `yaml:"repo"`
+	Version string `yaml:"version"`
+	Alias   string `yaml:"alias,omitempty"`
+}
+
+// Dir returns the directory the dependency is materialized into under
+// tests/vendor, preferring the alias when one is set.
+func (d Dependency) Dir() string {
+	if d.Alias != "" {
+		return d.Alias
+	}
+	return d.Name
+}
+
+// Chartfile is the parsed form of tests/chartfile.yaml.
+type Chartfile struct {
+	Dependencies []Dependency `yaml:"dependencies"`
+}
+
+// LockedDependency is a Dependency pinned to the exact version and digest
+// that was resolved, so repeated runs fetch identical bytes.
+type LockedDependency struct {
+	Dependency `yaml:",inline"`
+	Resolved   string `yaml:"resolved"`
+	Digest     string `yaml:"digest"`
+}
+
+// Lockfile is the parsed form of tests/chartfile.lock.
+type Lockfile struct {
+	Dependencies []LockedDependency `yaml:"dependencies"`
+}
+
+// LoadChartfile reads and parses the chartfile at path. A missing file is
+// treated as an empty Chartfile so charts without test-fixture dependencies
+// don't need to carry one.
+func LoadChartfile(path string) (*Chartfile, error) {
+	chartfile := &Chartfile{}
+
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return chartfile, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read chartfile %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(content, chartfile); err != nil {
+		return nil, fmt.Errorf("failed to parse chartfile %s: %w", path, err)
+	}
+
+	return chartfile, nil
+}
+
+// Save writes the chartfile back to path as YAML.
+func (c *Chartfile) Save(path string) error {
+	content, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to marshal chartfile: %w", err)
+	}
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("failed to write chartfile %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// LoadLockfile reads and parses the lockfile at path. A missing lockfile is
+// treated as empty, forcing every dependency to be resolved again.
+func LoadLockfile(path string) (*Lockfile, error) {
+	lockfile := &Lockfile{}
+
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return lockfile, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read lockfile %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(content, lockfile); err != nil {
+		return nil, fmt.Errorf("failed to parse lockfile %s: %w", path, err)
+	}
+
+	return lockfile, nil
+}
+
+// Save writes the lockfile back to path as YAML.
+func (l *Lockfile) Save(path string) error {
+	content, err := yaml.Marshal(l)
+	if err != nil {
+		return fmt.Errorf("failed to marshal lockfile: %w", err)
+	}
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("failed to write lockfile %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// find returns the locked entry matching dep by name and alias, if any.
+func (l *Lockfile) find(dep Dependency) (LockedDependency, bool) {
+	for _, locked := range l.Dependencies {
+		if locked.Name == dep.Name && locked.Alias == dep.Alias {
+			return locked, true
+		}
+	}
+	return LockedDependency{}, false
+}
+
+// put inserts or replaces the locked entry for dep.
+func (l *Lockfile) put(locked LockedDependency) {
+	for i, existing := range l.Dependencies {
+		if existing.Name == locked.Name && existing.Alias == locked.Alias {
+			l.Dependencies[i] = locked
+			return
+		}
+	}
+	l.Dependencies = append(l.Dependencies, locked)
+}
@@ -0,0 +1,212 @@
+package vendor
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// index is the subset of a Helm repo index.yaml this resolver needs.
+type index struct {
+	Entries map[string][]indexEntry `yaml:"entries"`
+}
+
+type indexEntry struct {
+	Version string   `yaml:"version"`
+	Urls    []string `yaml:"urls"`
+	Digest  string   `yaml:"digest"`
+}
+
+// Resolver fetches and verifies the chart archives a Chartfile declares.
+// It talks to plain Helm chart repositories (an index.yaml plus tgz
+// archives), the same sources `helm repo add` / `helm dependency update`
+// use for real chart dependencies.
+type Resolver struct {
+	// Client is the HTTP client used to fetch index files and archives.
+	// Defaults to http.DefaultClient when nil.
+	Client *http.Client
+}
+
+// Resolve downloads dep from repo, verifies its digest and extracts it into
+// destDir, returning the LockedDependency recording what was fetched.
+func (r Resolver) Resolve(dep Dependency, destDir string) (LockedDependency, error) {
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	entry, err := r.findEntry(client, dep)
+	if err != nil {
+		return LockedDependency{}, err
+	}
+
+	if len(entry.Urls) == 0 {
+		return LockedDependency{}, fmt.Errorf("chart %s@%s has no download urls in repo %s", dep.Name, dep.Version, dep.Repo)
+	}
+
+	archive, digest, err := fetchArchive(client, entry.Urls[0])
+	if err != nil {
+		return LockedDependency{}, err
+	}
+
+	if entry.Digest != "" && entry.Digest != digest {
+		return LockedDependency{}, fmt.Errorf("digest mismatch for %s@%s: index says %s, downloaded %s", dep.Name, dep.Version, entry.Digest, digest)
+	}
+
+	if err := extractTgz(archive, destDir); err != nil {
+		return LockedDependency{}, err
+	}
+
+	return LockedDependency{
+		Dependency: dep,
+		Resolved:   entry.Urls[0],
+		Digest:     digest,
+	}, nil
+}
+
+// findEntry fetches repo's index.yaml and returns the entry matching dep's
+// name and version.
+func (r Resolver) findEntry(client *http.Client, dep Dependency) (indexEntry, error) {
+	indexURL := strings.TrimRight(dep.Repo, "/") + "/index.yaml"
+
+	resp, err := client.Get(indexURL)
+	if err != nil {
+		return indexEntry{}, fmt.Errorf("failed to fetch %s: %w", indexURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return indexEntry{}, fmt.Errorf("failed to fetch %s: unexpected status %s", indexURL, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return indexEntry{}, fmt.Errorf("failed to read %s: %w", indexURL, err)
+	}
+
+	var idx index
+	if err := yaml.Unmarshal(body, &idx); err != nil {
+		return indexEntry{}, fmt.Errorf("failed to parse %s: %w", indexURL, err)
+	}
+
+	for _, entry := range idx.Entries[dep.Name] {
+		if entry.Version == dep.Version {
+			return entry, nil
+		}
+	}
+
+	return indexEntry{}, fmt.Errorf("chart %s@%s not found in repo %s", dep.Name, dep.Version, dep.Repo)
+}
+
+// fetchArchive downloads url and returns its bytes alongside the hex sha256
+// digest, in the "sha256:<hex>" form Helm repo indexes use.
+func fetchArchive(client *http.Client, url string) ([]byte, string, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to fetch %s: unexpected status %s", url, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read %s: %w", url, err)
+	}
+
+	sum := sha256.Sum256(body)
+	return body, fmt.Sprintf("sha256:%x", sum), nil
+}
+
+// extractTgz unpacks a gzipped tar chart archive into destDir, stripping the
+// leading "<chartname>/" path component every Helm chart archive carries.
+func extractTgz(archive []byte, destDir string) error {
+	gz, err := gzip.NewReader(strings.NewReader(string(archive)))
+	if err != nil {
+		return fmt.Errorf("failed to open chart archive: %w", err)
+	}
+	defer gz.Close()
+
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", destDir, err)
+	}
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read chart archive: %w", err)
+		}
+
+		relPath := stripFirstSegment(header.Name)
+		if relPath == "" {
+			continue
+		}
+
+		target, err := safeJoin(destDir, relPath)
+		if err != nil {
+			return fmt.Errorf("chart archive entry %q: %w", header.Name, err)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return fmt.Errorf("failed to create %s: %w", target, err)
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return fmt.Errorf("failed to create %s: %w", filepath.Dir(target), err)
+			}
+			out, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(header.Mode))
+			if err != nil {
+				return fmt.Errorf("failed to create %s: %w", target, err)
+			}
+			if _, err := io.Copy(out, tr); err != nil {
+				out.Close()
+				return fmt.Errorf("failed to write %s: %w", target, err)
+			}
+			out.Close()
+		}
+	}
+}
+
+// stripFirstSegment removes the leading "<chartname>/" component Helm chart
+// archives wrap every entry in.
+func stripFirstSegment(name string) string {
+	name = filepath.ToSlash(name)
+	if idx := strings.Index(name, "/"); idx >= 0 {
+		return name[idx+1:]
+	}
+	return ""
+}
+
+// safeJoin joins relPath onto destDir and rejects the result if relPath
+// escapes destDir, e.g. via a ".." segment or an absolute path ("tar-slip").
+// Chart archives are fetched from whatever repo the chartfile points at, so
+// this input must be treated as untrusted.
+func safeJoin(destDir, relPath string) (string, error) {
+	if filepath.IsAbs(relPath) {
+		return "", fmt.Errorf("refusing to extract absolute path %q", relPath)
+	}
+
+	target := filepath.Join(destDir, relPath)
+
+	destDirWithSep := destDir + string(os.PathSeparator)
+	if target != destDir && !strings.HasPrefix(target, destDirWithSep) {
+		return "", fmt.Errorf("refusing to extract %q outside of %s", relPath, destDir)
+	}
+
+	return target, nil
+}
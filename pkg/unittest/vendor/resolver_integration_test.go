@@ -0,0 +1,119 @@
+package vendor
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newIndexServer(t *testing.T, indexBody func(serverURL string) string, archive []byte, archivePath string) *httptest.Server {
+	t.Helper()
+
+	var server *httptest.Server
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/index.yaml", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, indexBody(server.URL))
+	})
+	mux.HandleFunc(archivePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archive)
+	})
+
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestResolverResolveHappyPath(t *testing.T) {
+	archive := buildTgz(t, map[string]string{"common/Chart.yaml": "name: common\nversion: 1.2.3\n"})
+	sum := sha256.Sum256(archive)
+	digest := fmt.Sprintf("sha256:%x", sum)
+
+	server := newIndexServer(t, func(serverURL string) string {
+		return fmt.Sprintf(`entries:
+  common:
+    - version: "1.2.3"
+      urls:
+        - %s/common-1.2.3.tgz
+      digest: %s
+`, serverURL, digest)
+	}, archive, "/common-1.2.3.tgz")
+
+	destDir := filepath.Join(t.TempDir(), "common")
+	dep := Dependency{Name: "common", Repo: server.URL, Version: "1.2.3"}
+
+	locked, err := (Resolver{}).Resolve(dep, destDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if locked.Digest != digest {
+		t.Errorf("locked digest = %q, want %q", locked.Digest, digest)
+	}
+	if locked.Resolved != server.URL+"/common-1.2.3.tgz" {
+		t.Errorf("locked resolved url = %q, want %q", locked.Resolved, server.URL+"/common-1.2.3.tgz")
+	}
+}
+
+func TestResolverResolveRejectsDigestMismatch(t *testing.T) {
+	archive := buildTgz(t, map[string]string{"common/Chart.yaml": "name: common\nversion: 1.2.3\n"})
+
+	server := newIndexServer(t, func(serverURL string) string {
+		return fmt.Sprintf(`entries:
+  common:
+    - version: "1.2.3"
+      urls:
+        - %s/common-1.2.3.tgz
+      digest: sha256:0000000000000000000000000000000000000000000000000000000000000000
+`, serverURL)
+	}, archive, "/common-1.2.3.tgz")
+
+	destDir := filepath.Join(t.TempDir(), "common")
+	dep := Dependency{Name: "common", Repo: server.URL, Version: "1.2.3"}
+
+	_, err := (Resolver{}).Resolve(dep, destDir)
+	if err == nil {
+		t.Fatal("expected digest mismatch to be rejected, got nil error")
+	}
+	if !strings.Contains(err.Error(), "digest mismatch") {
+		t.Errorf("expected a digest mismatch error, got: %v", err)
+	}
+}
+
+func TestResolverResolveIndexNotFound(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	destDir := filepath.Join(t.TempDir(), "common")
+	dep := Dependency{Name: "common", Repo: server.URL, Version: "1.2.3"}
+
+	_, err := (Resolver{}).Resolve(dep, destDir)
+	if err == nil {
+		t.Fatal("expected a missing index.yaml to be an error, got nil")
+	}
+}
+
+func TestResolverResolveArchiveNotFound(t *testing.T) {
+	server := newIndexServer(t, func(serverURL string) string {
+		return fmt.Sprintf(`entries:
+  common:
+    - version: "1.2.3"
+      urls:
+        - %s/missing-common-1.2.3.tgz
+      digest: sha256:deadbeef
+`, serverURL)
+	}, []byte("irrelevant"), "/common-1.2.3.tgz")
+
+	destDir := filepath.Join(t.TempDir(), "common")
+	dep := Dependency{Name: "common", Repo: server.URL, Version: "1.2.3"}
+
+	_, err := (Resolver{}).Resolve(dep, destDir)
+	if err == nil {
+		t.Fatal("expected a 404 archive response to be an error, got nil")
+	}
+}
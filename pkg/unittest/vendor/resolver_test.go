@@ -0,0 +1,92 @@
+package vendor
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// buildTgz packs entries (name -> content) into a gzipped tar archive, the
+// same shape a Helm chart repo serves for a chart version.
+func buildTgz(t *testing.T, entries map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for name, content := range entries {
+		header := &tar.Header{
+			Name: name,
+			Mode: 0644,
+			Size: int64(len(content)),
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatalf("failed to write tar header for %s: %v", name, err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatalf("failed to write tar content for %s: %v", name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatalf("failed to close tar writer: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("failed to close gzip writer: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestExtractTgzRejectsPathTraversal(t *testing.T) {
+	destDir := filepath.Join(t.TempDir(), "common")
+
+	archive := buildTgz(t, map[string]string{
+		"common/../../../../tmp/evil-helm-unittest-vendor-test": "pwned",
+	})
+
+	if err := extractTgz(archive, destDir); err == nil {
+		t.Fatal("expected extractTgz to reject a path-traversal entry, got nil error")
+	}
+
+	if _, err := os.Stat(filepath.Join(filepath.Dir(destDir), "evil-helm-unittest-vendor-test")); !os.IsNotExist(err) {
+		t.Fatal("extractTgz wrote outside destDir despite returning an error")
+	}
+}
+
+func TestExtractTgzRejectsAbsolutePath(t *testing.T) {
+	destDir := filepath.Join(t.TempDir(), "common")
+
+	archive := buildTgz(t, map[string]string{
+		"common//etc/cron.d/evil": "pwned",
+	})
+
+	if err := extractTgz(archive, destDir); err == nil {
+		t.Fatal("expected extractTgz to reject an absolute-path entry, got nil error")
+	}
+}
+
+func TestExtractTgzWritesWellFormedArchive(t *testing.T) {
+	destDir := filepath.Join(t.TempDir(), "common")
+
+	archive := buildTgz(t, map[string]string{
+		"common/Chart.yaml":          "name: common\n",
+		"common/templates/_helpers": "{{/* helpers */}}",
+	})
+
+	if err := extractTgz(archive, destDir); err != nil {
+		t.Fatalf("unexpected error extracting well-formed archive: %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(destDir, "Chart.yaml"))
+	if err != nil {
+		t.Fatalf("expected Chart.yaml to be extracted: %v", err)
+	}
+	if string(content) != "name: common\n" {
+		t.Fatalf("unexpected Chart.yaml content: %q", content)
+	}
+}
@@ -0,0 +1,130 @@
+package vendor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Sync reads ChartfilePath and LockfilePath (relative to chartDir), resolves
+// any dependency that is new or whose lockfile entry no longer matches, and
+// materializes the result into VendorDir. It is safe to call on every run:
+// dependencies already satisfied by the lockfile are left untouched.
+//
+// Sync returns the paths every vendored dependency was extracted into
+// (chartDir joined with VendorDir, so relative when chartDir is relative),
+// in chartfile order, so the caller can add them to the chart loader's
+// dependency search path alongside the chart's own `charts/` directory.
+func Sync(chartDir string) ([]string, error) {
+	chartfile, err := LoadChartfile(filepath.Join(chartDir, ChartfilePath))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chartfile.Dependencies) == 0 {
+		return nil, nil
+	}
+
+	lockfile, err := LoadLockfile(filepath.Join(chartDir, LockfilePath))
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := Resolver{}
+	dirs := make([]string, 0, len(chartfile.Dependencies))
+
+	for _, dep := range chartfile.Dependencies {
+		destDir := filepath.Join(chartDir, VendorDir, dep.Dir())
+		dirs = append(dirs, destDir)
+
+		if locked, ok := lockfile.find(dep); ok && locked.Dependency == dep {
+			if _, err := os.Stat(destDir); err == nil {
+				continue
+			}
+		}
+
+		locked, err := resolver.Resolve(dep, destDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to vendor %s: %w", dep.Dir(), err)
+		}
+
+		lockfile.put(locked)
+	}
+
+	if err := lockfile.Save(filepath.Join(chartDir, LockfilePath)); err != nil {
+		return nil, err
+	}
+
+	return dirs, nil
+}
+
+// ValidateDirs checks that every directory Sync returned still exists and
+// is a directory, catching a vendored chart that was deleted or replaced
+// with a file after Sync ran (e.g. by a stale lockfile pointing at a
+// directory removed outside of helm-unittest) before rendering fails deep
+// inside the chart loader with a confusing error.
+func ValidateDirs(dirs []string) error {
+	for _, dir := range dirs {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return fmt.Errorf("vendored chart dependency missing at %s: %w", dir, err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("vendored chart dependency at %s is not a directory", dir)
+		}
+	}
+
+	return nil
+}
+
+// Add parses a "repo/name@version" reference, appends it
+// to the chartfile at chartDir as a new Dependency and returns it. It does
+// not resolve the dependency; call Sync to materialize it.
+func Add(chartDir, ref string) (Dependency, error) {
+	dep, err := parseRef(ref)
+	if err != nil {
+		return Dependency{}, err
+	}
+
+	chartfilePath := filepath.Join(chartDir, ChartfilePath)
+
+	chartfile, err := LoadChartfile(chartfilePath)
+	if err != nil {
+		return Dependency{}, err
+	}
+
+	chartfile.Dependencies = append(chartfile.Dependencies, dep)
+
+	if err := os.MkdirAll(filepath.Dir(chartfilePath), 0755); err != nil {
+		return Dependency{}, fmt.Errorf("failed to create %s: %w", filepath.Dir(chartfilePath), err)
+	}
+
+	if err := chartfile.Save(chartfilePath); err != nil {
+		return Dependency{}, err
+	}
+
+	return dep, nil
+}
+
+// parseRef parses "repo/name@version" into a Dependency. repo is expected to
+// already be resolvable to a chart repository URL by the caller; helm-unittest
+// does not maintain its own repo alias list, so a bare alias like "bitnami"
+// must be passed as a full URL.
+func parseRef(ref string) (Dependency, error) {
+	nameAndVersion, version, ok := strings.Cut(ref, "@")
+	if !ok {
+		return Dependency{}, fmt.Errorf("invalid chart reference %q, expected repo/name@version", ref)
+	}
+
+	idx := strings.LastIndex(nameAndVersion, "/")
+	if idx < 0 {
+		return Dependency{}, fmt.Errorf("invalid chart reference %q, expected repo/name@version", ref)
+	}
+
+	return Dependency{
+		Name:    nameAndVersion[idx+1:],
+		Repo:    nameAndVersion[:idx],
+		Version: version,
+	}, nil
+}
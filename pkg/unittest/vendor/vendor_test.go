@@ -0,0 +1,36 @@
+package vendor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateDirsAcceptsExistingDirectories(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := ValidateDirs([]string{dir}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateDirsRejectsMissingDirectory(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "does-not-exist")
+
+	if err := ValidateDirs([]string{missing}); err == nil {
+		t.Fatal("expected an error for a missing vendored directory, got nil")
+	}
+}
+
+func TestValidateDirsRejectsFileInPlaceOfDirectory(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "common")
+
+	if err := os.WriteFile(file, []byte("not a directory"), 0644); err != nil {
+		t.Fatalf("failed to set up test file: %v", err)
+	}
+
+	if err := ValidateDirs([]string{file}); err == nil {
+		t.Fatal("expected an error when the vendored path is a file, got nil")
+	}
+}